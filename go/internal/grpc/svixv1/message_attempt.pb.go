@@ -0,0 +1,200 @@
+// Package svixv1 is a hand-maintained placeholder for the types
+// proto/svix/v1/message_attempt.proto describes.
+//
+// It is NOT the output of protoc-gen-go: this environment has no buf/protoc
+// toolchain to run `make generate-grpc` against, so there is no
+// descriptor-backed ProtoReflect()/rawDesc here, and these structs do not
+// implement the full proto.Message contract. Running `make generate-grpc`
+// on a machine with the toolchain installed will replace this file with
+// real generated code; keep the struct shapes below in sync with the
+// .proto by hand until then.
+package svixv1
+
+import (
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ListAttemptsByEndpointRequest struct {
+	AppId      string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	EndpointId string `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	Iterator   string `protobuf:"bytes,3,opt,name=iterator,proto3" json:"iterator,omitempty"`
+	Limit      int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListAttemptsByEndpointRequest) Reset()         { *x = ListAttemptsByEndpointRequest{} }
+func (x *ListAttemptsByEndpointRequest) String() string { return "" }
+func (*ListAttemptsByEndpointRequest) ProtoMessage()    {}
+
+func (x *ListAttemptsByEndpointRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *ListAttemptsByEndpointRequest) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *ListAttemptsByEndpointRequest) GetIterator() string {
+	if x != nil {
+		return x.Iterator
+	}
+	return ""
+}
+
+func (x *ListAttemptsByEndpointRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetAttemptRequest struct {
+	AppId     string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	MsgId     string `protobuf:"bytes,2,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	AttemptId string `protobuf:"bytes,3,opt,name=attempt_id,json=attemptId,proto3" json:"attempt_id,omitempty"`
+}
+
+func (x *GetAttemptRequest) Reset()         { *x = GetAttemptRequest{} }
+func (x *GetAttemptRequest) String() string { return "" }
+func (*GetAttemptRequest) ProtoMessage()    {}
+
+func (x *GetAttemptRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GetAttemptRequest) GetMsgId() string {
+	if x != nil {
+		return x.MsgId
+	}
+	return ""
+}
+
+func (x *GetAttemptRequest) GetAttemptId() string {
+	if x != nil {
+		return x.AttemptId
+	}
+	return ""
+}
+
+// ListResponseMessageAttemptEndpointOut mirrors the Go
+// openapi.ListResponseMessageAttemptEndpointOut model; see ToProto()/
+// FromProto() in go/internal/openapi/grpc_convert.go for the conversion.
+//
+// iterator is never null on the wire: HTTP's NullableString collapses to ""
+// once Done is true.
+//
+// prev_iterator is `optional` in the .proto, which protoc-gen-go backs with
+// a synthetic oneof wrapper that this placeholder does not implement (see
+// the package doc comment) - PrevIterator is a plain nil-able *string here,
+// without the `,oneof` struct tag real generated code would carry.
+type ListResponseMessageAttemptEndpointOut struct {
+	Data         []*MessageAttemptEndpointOut `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Done         bool                         `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	Iterator     string                       `protobuf:"bytes,3,opt,name=iterator,proto3" json:"iterator,omitempty"`
+	PrevIterator *string                      `protobuf:"bytes,4,opt,name=prev_iterator,json=prevIterator,proto3" json:"prev_iterator,omitempty"`
+}
+
+func (x *ListResponseMessageAttemptEndpointOut) Reset() {
+	*x = ListResponseMessageAttemptEndpointOut{}
+}
+func (x *ListResponseMessageAttemptEndpointOut) String() string { return "" }
+func (*ListResponseMessageAttemptEndpointOut) ProtoMessage()    {}
+
+func (x *ListResponseMessageAttemptEndpointOut) GetData() []*MessageAttemptEndpointOut {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListResponseMessageAttemptEndpointOut) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *ListResponseMessageAttemptEndpointOut) GetIterator() string {
+	if x != nil {
+		return x.Iterator
+	}
+	return ""
+}
+
+func (x *ListResponseMessageAttemptEndpointOut) GetPrevIterator() string {
+	if x != nil && x.PrevIterator != nil {
+		return *x.PrevIterator
+	}
+	return ""
+}
+
+type MessageAttemptEndpointOut struct {
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MsgId              string                 `protobuf:"bytes,2,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	EndpointId         string                 `protobuf:"bytes,3,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	Status             string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Response           string                 `protobuf:"bytes,5,opt,name=response,proto3" json:"response,omitempty"`
+	ResponseStatusCode int32                  `protobuf:"varint,6,opt,name=response_status_code,json=responseStatusCode,proto3" json:"response_status_code,omitempty"`
+	Timestamp          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *MessageAttemptEndpointOut) Reset()         { *x = MessageAttemptEndpointOut{} }
+func (x *MessageAttemptEndpointOut) String() string { return "" }
+func (*MessageAttemptEndpointOut) ProtoMessage()    {}
+
+func (x *MessageAttemptEndpointOut) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MessageAttemptEndpointOut) GetMsgId() string {
+	if x != nil {
+		return x.MsgId
+	}
+	return ""
+}
+
+func (x *MessageAttemptEndpointOut) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *MessageAttemptEndpointOut) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MessageAttemptEndpointOut) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *MessageAttemptEndpointOut) GetResponseStatusCode() int32 {
+	if x != nil {
+		return x.ResponseStatusCode
+	}
+	return 0
+}
+
+func (x *MessageAttemptEndpointOut) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
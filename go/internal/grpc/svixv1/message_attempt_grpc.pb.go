@@ -0,0 +1,200 @@
+// This file is a hand-maintained placeholder for the gRPC client/server
+// stubs proto/svix/v1/message_attempt.proto describes - see the package
+// doc comment in message_attempt.pb.go for why it isn't real
+// protoc-gen-go-grpc output yet. Running `make generate-grpc` on a machine
+// with buf/protoc-gen-go-grpc installed will replace it.
+
+package svixv1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+const (
+	MessageAttemptService_ListAttemptsByEndpoint_FullMethodName   = "/svix.v1.MessageAttemptService/ListAttemptsByEndpoint"
+	MessageAttemptService_StreamAttemptsByEndpoint_FullMethodName = "/svix.v1.MessageAttemptService/StreamAttemptsByEndpoint"
+	MessageAttemptService_GetAttempt_FullMethodName               = "/svix.v1.MessageAttemptService/GetAttempt"
+)
+
+// MessageAttemptServiceClient is the client API for MessageAttemptService
+// service, mirroring the HTTP MessageAttempt endpoints so a client running
+// alongside a self-hosted Svix server can bypass HTTP/JSON entirely.
+type MessageAttemptServiceClient interface {
+	ListAttemptsByEndpoint(ctx context.Context, in *ListAttemptsByEndpointRequest, opts ...grpc.CallOption) (*ListResponseMessageAttemptEndpointOut, error)
+	StreamAttemptsByEndpoint(ctx context.Context, in *ListAttemptsByEndpointRequest, opts ...grpc.CallOption) (MessageAttemptService_StreamAttemptsByEndpointClient, error)
+	GetAttempt(ctx context.Context, in *GetAttemptRequest, opts ...grpc.CallOption) (*MessageAttemptEndpointOut, error)
+}
+
+type messageAttemptServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMessageAttemptServiceClient(cc grpc.ClientConnInterface) MessageAttemptServiceClient {
+	return &messageAttemptServiceClient{cc}
+}
+
+func (c *messageAttemptServiceClient) ListAttemptsByEndpoint(ctx context.Context, in *ListAttemptsByEndpointRequest, opts ...grpc.CallOption) (*ListResponseMessageAttemptEndpointOut, error) {
+	out := new(ListResponseMessageAttemptEndpointOut)
+	if err := c.cc.Invoke(ctx, MessageAttemptService_ListAttemptsByEndpoint_FullMethodName, in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageAttemptServiceClient) StreamAttemptsByEndpoint(ctx context.Context, in *ListAttemptsByEndpointRequest, opts ...grpc.CallOption) (MessageAttemptService_StreamAttemptsByEndpointClient, error) {
+	stream, err := c.cc.NewStream(ctx, &messageAttemptServiceStreamAttemptsByEndpointStreamDesc, MessageAttemptService_StreamAttemptsByEndpoint_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &messageAttemptServiceStreamAttemptsByEndpointClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// MessageAttemptService_StreamAttemptsByEndpointClient is the stream handle
+// returned by StreamAttemptsByEndpoint; Recv collapses Iterator-based
+// pagination into one page per call.
+type MessageAttemptService_StreamAttemptsByEndpointClient interface {
+	Recv() (*ListResponseMessageAttemptEndpointOut, error)
+	grpc.ClientStream
+}
+
+type messageAttemptServiceStreamAttemptsByEndpointClient struct {
+	grpc.ClientStream
+}
+
+func (x *messageAttemptServiceStreamAttemptsByEndpointClient) Recv() (*ListResponseMessageAttemptEndpointOut, error) {
+	m := new(ListResponseMessageAttemptEndpointOut)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *messageAttemptServiceClient) GetAttempt(ctx context.Context, in *GetAttemptRequest, opts ...grpc.CallOption) (*MessageAttemptEndpointOut, error) {
+	out := new(MessageAttemptEndpointOut)
+	if err := c.cc.Invoke(ctx, MessageAttemptService_GetAttempt_FullMethodName, in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MessageAttemptServiceServer is the server API for MessageAttemptService
+// service. UnimplementedMessageAttemptServiceServer must be embedded for
+// forward compatibility.
+type MessageAttemptServiceServer interface {
+	ListAttemptsByEndpoint(context.Context, *ListAttemptsByEndpointRequest) (*ListResponseMessageAttemptEndpointOut, error)
+	StreamAttemptsByEndpoint(*ListAttemptsByEndpointRequest, MessageAttemptService_StreamAttemptsByEndpointServer) error
+	GetAttempt(context.Context, *GetAttemptRequest) (*MessageAttemptEndpointOut, error)
+}
+
+type UnimplementedMessageAttemptServiceServer struct{}
+
+func (UnimplementedMessageAttemptServiceServer) ListAttemptsByEndpoint(context.Context, *ListAttemptsByEndpointRequest) (*ListResponseMessageAttemptEndpointOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAttemptsByEndpoint not implemented")
+}
+func (UnimplementedMessageAttemptServiceServer) StreamAttemptsByEndpoint(*ListAttemptsByEndpointRequest, MessageAttemptService_StreamAttemptsByEndpointServer) error {
+	return status.Error(codes.Unimplemented, "method StreamAttemptsByEndpoint not implemented")
+}
+func (UnimplementedMessageAttemptServiceServer) GetAttempt(context.Context, *GetAttemptRequest) (*MessageAttemptEndpointOut, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAttempt not implemented")
+}
+
+func RegisterMessageAttemptServiceServer(s grpc.ServiceRegistrar, srv MessageAttemptServiceServer) {
+	s.RegisterService(&MessageAttemptService_ServiceDesc, srv)
+}
+
+func _MessageAttemptService_ListAttemptsByEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttemptsByEndpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageAttemptServiceServer).ListAttemptsByEndpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageAttemptService_ListAttemptsByEndpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageAttemptServiceServer).ListAttemptsByEndpoint(ctx, req.(*ListAttemptsByEndpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageAttemptService_StreamAttemptsByEndpoint_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAttemptsByEndpointRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MessageAttemptServiceServer).StreamAttemptsByEndpoint(m, &messageAttemptServiceStreamAttemptsByEndpointServer{stream})
+}
+
+// MessageAttemptService_StreamAttemptsByEndpointServer is the server-side
+// handle for the StreamAttemptsByEndpoint RPC.
+type MessageAttemptService_StreamAttemptsByEndpointServer interface {
+	Send(*ListResponseMessageAttemptEndpointOut) error
+	grpc.ServerStream
+}
+
+type messageAttemptServiceStreamAttemptsByEndpointServer struct {
+	grpc.ServerStream
+}
+
+func (x *messageAttemptServiceStreamAttemptsByEndpointServer) Send(m *ListResponseMessageAttemptEndpointOut) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MessageAttemptService_GetAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageAttemptServiceServer).GetAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageAttemptService_GetAttempt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageAttemptServiceServer).GetAttempt(ctx, req.(*GetAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var messageAttemptServiceStreamAttemptsByEndpointStreamDesc = grpc.StreamDesc{
+	StreamName:    "StreamAttemptsByEndpoint",
+	Handler:       _MessageAttemptService_StreamAttemptsByEndpoint_Handler,
+	ServerStreams: true,
+}
+
+// MessageAttemptService_ServiceDesc is the grpc.ServiceDesc for
+// MessageAttemptService.
+var MessageAttemptService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "svix.v1.MessageAttemptService",
+	HandlerType: (*MessageAttemptServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAttemptsByEndpoint",
+			Handler:    _MessageAttemptService_ListAttemptsByEndpoint_Handler,
+		},
+		{
+			MethodName: "GetAttempt",
+			Handler:    _MessageAttemptService_GetAttempt_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		messageAttemptServiceStreamAttemptsByEndpointStreamDesc,
+	},
+	Metadata: "svix/v1/message_attempt.proto",
+}
@@ -0,0 +1,135 @@
+package openapi
+
+import (
+	"context"
+	"io"
+
+	"google.golang.org/grpc"
+
+	svixv1 "github.com/svix/svix-webhooks/go/internal/grpc/svixv1"
+)
+
+// Backend abstracts the wire transport an API method is sent over, so
+// generated methods can be written once against the interface and work
+// unmodified whether the client is configured for HTTP+JSON or, when
+// running alongside a self-hosted Svix server, gRPC.
+type Backend interface {
+	ListAttemptsByEndpoint(ctx context.Context, appID, endpointID, iterator string, limit int32) (ListResponseMessageAttemptEndpointOut, error)
+	GetAttempt(ctx context.Context, appID, msgID, attemptID string) (MessageAttemptEndpointOut, error)
+}
+
+// HTTPBackend is the default Backend: a thin pass-through to the existing
+// generated MessageAttemptApiService, so Backend can be introduced without
+// touching any HTTP/JSON call sites.
+type HTTPBackend struct {
+	Api *MessageAttemptApiService
+}
+
+var _ Backend = (*HTTPBackend)(nil)
+
+func (b *HTTPBackend) ListAttemptsByEndpoint(ctx context.Context, appID, endpointID, iterator string, limit int32) (ListResponseMessageAttemptEndpointOut, error) {
+	req := b.Api.ListAttemptsByEndpoint(ctx, appID, endpointID)
+	if iterator != "" {
+		req = req.Iterator(iterator)
+	}
+	if limit > 0 {
+		req = req.Limit(limit)
+	}
+	return req.Execute()
+}
+
+func (b *HTTPBackend) GetAttempt(ctx context.Context, appID, msgID, attemptID string) (MessageAttemptEndpointOut, error) {
+	return b.Api.GetAttempt(ctx, appID, msgID, attemptID).Execute()
+}
+
+// GRPCBackend sends requests over the gRPC transport defined in
+// proto/svix/v1/message_attempt.proto, for clients running inside a
+// cluster alongside a self-hosted Svix server that want to bypass
+// HTTP/JSON entirely.
+type GRPCBackend struct {
+	Conn *grpc.ClientConn
+
+	client svixv1.MessageAttemptServiceClient
+}
+
+var _ Backend = (*GRPCBackend)(nil)
+
+// NewGRPCBackend builds a GRPCBackend around an already-dialed conn, e.g.
+// grpc.Dial("svix-server.svc.cluster.local:8071", ...).
+func NewGRPCBackend(conn *grpc.ClientConn) *GRPCBackend {
+	return &GRPCBackend{Conn: conn, client: svixv1.NewMessageAttemptServiceClient(conn)}
+}
+
+func (b *GRPCBackend) ListAttemptsByEndpoint(ctx context.Context, appID, endpointID, iterator string, limit int32) (ListResponseMessageAttemptEndpointOut, error) {
+	resp, err := b.client.ListAttemptsByEndpoint(ctx, &svixv1.ListAttemptsByEndpointRequest{
+		AppId:      appID,
+		EndpointId: endpointID,
+		Iterator:   iterator,
+		Limit:      limit,
+	})
+	if err != nil {
+		return ListResponseMessageAttemptEndpointOut{}, err
+	}
+	return FromProtoListResponseMessageAttemptEndpointOut(resp), nil
+}
+
+func (b *GRPCBackend) GetAttempt(ctx context.Context, appID, msgID, attemptID string) (MessageAttemptEndpointOut, error) {
+	resp, err := b.client.GetAttempt(ctx, &svixv1.GetAttemptRequest{
+		AppId:     appID,
+		MsgId:     msgID,
+		AttemptId: attemptID,
+	})
+	if err != nil {
+		return MessageAttemptEndpointOut{}, err
+	}
+	return FromProtoMessageAttemptEndpointOut(resp), nil
+}
+
+// StreamResult is delivered on the channel returned by
+// StreamAttemptsByEndpoint. A non-nil Err (the stream's final value before
+// the channel closes) means the stream ended early; io.EOF is treated as
+// normal completion and never delivered. This mirrors the
+// pagination.Paginator.Stream contract of surfacing a failed fetch as the
+// final value instead of closing the channel indistinguishably from
+// success.
+type StreamResult struct {
+	Page ListResponseMessageAttemptEndpointOut
+	Err  error
+}
+
+// StreamAttemptsByEndpoint collapses Iterator-based pagination into the
+// server-streaming RPC of the same name, for GRPCBackend callers who want
+// every page without driving pagination.Paginator themselves.
+func (b *GRPCBackend) StreamAttemptsByEndpoint(ctx context.Context, appID, endpointID string, limit int32) (<-chan StreamResult, error) {
+	stream, err := b.client.StreamAttemptsByEndpoint(ctx, &svixv1.ListAttemptsByEndpointRequest{
+		AppId:      appID,
+		EndpointId: endpointID,
+		Limit:      limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan StreamResult)
+	go func() {
+		defer close(out)
+		for {
+			page, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF {
+					select {
+					case out <- StreamResult{Err: err}:
+					case <-ctx.Done():
+					}
+				}
+				return
+			}
+			select {
+			case out <- StreamResult{Page: FromProtoListResponseMessageAttemptEndpointOut(page)}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
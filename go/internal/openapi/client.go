@@ -0,0 +1,28 @@
+package openapi
+
+import "net/http"
+
+// NumTries is the maximum number of attempts the client makes for a single
+// request before giving up. Transport's DefaultRetryPolicy reuses it so the
+// two stay in lock-step.
+const NumTries = 3
+
+// Configuration stores the HTTPClient that generated API methods send
+// requests through, and an optional Backend.
+type Configuration struct {
+	HTTPClient *http.Client
+	// Backend holds an alternative Backend, e.g. a GRPCBackend for clients
+	// running alongside a self-hosted Svix server. Generated API methods do
+	// not read this field; they always send through HTTPClient.
+	Backend Backend
+}
+
+// NewConfiguration builds a Configuration with Transport installed on
+// HTTPClient by default, so every generated API method - and pagination
+// built on top of it - picks up retries, rate-limit awareness, and tracing
+// without any extra wiring.
+func NewConfiguration() *Configuration {
+	return &Configuration{
+		HTTPClient: &http.Client{Transport: &Transport{}},
+	}
+}
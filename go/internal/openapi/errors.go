@@ -0,0 +1,114 @@
+package openapi
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// DecodeErrorCode classifies why UnmarshalJSON rejected a payload, so
+// callers can branch on the failure instead of matching error strings.
+type DecodeErrorCode string
+
+const (
+	// ErrMissingRequired means a required property was absent from the
+	// payload entirely.
+	ErrMissingRequired DecodeErrorCode = "missing_required"
+	// ErrUnknownField means the payload contained a property the model
+	// doesn't define.
+	ErrUnknownField DecodeErrorCode = "unknown_field"
+	// ErrTypeMismatch means a property was present but didn't decode into
+	// its declared Go type.
+	ErrTypeMismatch DecodeErrorCode = "type_mismatch"
+	// ErrInvalidNullable means a Nullable* field's value didn't decode,
+	// e.g. a non-string value for a NullableString.
+	ErrInvalidNullable DecodeErrorCode = "invalid_nullable"
+)
+
+// DecodeError is returned by generated UnmarshalJSON methods in place of a
+// flat fmt.Errorf string. Path is an RFC 6901 JSON Pointer to the offending
+// location in the payload, e.g. "/data/0/response/status_code".
+type DecodeError struct {
+	Code  DecodeErrorCode
+	Field string
+	Path  string
+	Err   error
+}
+
+func (e *DecodeError) Error() string {
+	if e.Err != nil {
+		return string(e.Code) + " at " + e.Path + ": " + e.Err.Error()
+	}
+	return string(e.Code) + " at " + e.Path
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeErrors aggregates every DecodeError found while decoding a single
+// document, so a payload with multiple problems is reported in one pass
+// instead of failing fast on the first.
+type DecodeErrors []*DecodeError
+
+func (e DecodeErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, d := range e {
+		msgs[i] = d.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ErrorsAs reports whether err is or wraps a DecodeErrors, and if so returns
+// it, mirroring the stdlib errors.As pattern used elsewhere for branching on
+// required-field vs unknown-field failures.
+func ErrorsAs(err error) (DecodeErrors, bool) {
+	var decodeErrs DecodeErrors
+	if errors.As(err, &decodeErrs) {
+		return decodeErrs, true
+	}
+	var single *DecodeError
+	if errors.As(err, &single) {
+		return DecodeErrors{single}, true
+	}
+	return nil, false
+}
+
+// decodeErrorFromJSON classifies an error returned by json.Decoder.Decode
+// into a *DecodeError, so the final Decode in generated UnmarshalJSON
+// methods never surfaces a bare encoding/json error. Missing-required, unknown-field, and
+// invalid-nullable payloads are already classified against allProperties
+// before Decode ever runs, so by the time Decode fails the only remaining
+// possibility is a type mismatch on a known field.
+func decodeErrorFromJSON(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return &DecodeError{
+			Code:  ErrTypeMismatch,
+			Field: typeErr.Field,
+			Path:  jsonPointer(strings.Split(typeErr.Field, ".")...),
+			Err:   err,
+		}
+	}
+
+	return &DecodeError{Code: ErrTypeMismatch, Path: jsonPointer(), Err: err}
+}
+
+// jsonPointer builds an RFC 6901 JSON Pointer from path segments, escaping
+// "~" and "/" per the spec.
+func jsonPointer(segments ...string) string {
+	if len(segments) == 0 {
+		return ""
+	}
+	escaped := make([]string, len(segments))
+	for i, s := range segments {
+		s = strings.ReplaceAll(s, "~", "~0")
+		s = strings.ReplaceAll(s, "/", "~1")
+		escaped[i] = s
+	}
+	return "/" + strings.Join(escaped, "/")
+}
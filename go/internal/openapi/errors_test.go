@@ -0,0 +1,77 @@
+package openapi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestJSONPointerEscaping(t *testing.T) {
+	cases := []struct {
+		segments []string
+		want     string
+	}{
+		{nil, ""},
+		{[]string{"data"}, "/data"},
+		{[]string{"data", "0", "status_code"}, "/data/0/status_code"},
+		{[]string{"a/b"}, "/a~1b"},
+		{[]string{"a~b"}, "/a~0b"},
+		{[]string{"a~/b"}, "/a~0~1b"},
+	}
+	for _, c := range cases {
+		if got := jsonPointer(c.segments...); got != c.want {
+			t.Errorf("jsonPointer(%q) = %q, want %q", c.segments, got, c.want)
+		}
+	}
+}
+
+func TestDecodeErrorsErrorAggregatesAllMessages(t *testing.T) {
+	errs := DecodeErrors{
+		&DecodeError{Code: ErrMissingRequired, Field: "done", Path: jsonPointer("done")},
+		&DecodeError{Code: ErrUnknownField, Field: "extra", Path: jsonPointer("extra")},
+	}
+	msg := errs.Error()
+	if !strings.Contains(msg, "missing_required at /done") {
+		t.Errorf("Error() = %q, missing the missing_required entry", msg)
+	}
+	if !strings.Contains(msg, "unknown_field at /extra") {
+		t.Errorf("Error() = %q, missing the unknown_field entry", msg)
+	}
+}
+
+func TestErrorsAsUnwrapsSingleAndAggregate(t *testing.T) {
+	single := &DecodeError{Code: ErrTypeMismatch, Path: jsonPointer("done")}
+	if got, ok := ErrorsAs(single); !ok || len(got) != 1 || got[0] != single {
+		t.Errorf("ErrorsAs(single) = %v, %v", got, ok)
+	}
+
+	agg := DecodeErrors{single}
+	if got, ok := ErrorsAs(error(agg)); !ok || len(got) != 1 || got[0] != single {
+		t.Errorf("ErrorsAs(agg) = %v, %v", got, ok)
+	}
+
+	if _, ok := ErrorsAs(nil); ok {
+		t.Errorf("ErrorsAs(nil) reported ok, want false")
+	}
+}
+
+func TestDecodeErrorFromJSONClassifiesTypeMismatch(t *testing.T) {
+	var v struct {
+		Done bool `json:"done"`
+	}
+	err := json.Unmarshal([]byte(`{"done": "not-a-bool"}`), &v)
+	if err == nil {
+		t.Fatal("json.Unmarshal unexpectedly succeeded")
+	}
+	decErr := decodeErrorFromJSON(err)
+	de, ok := decErr.(*DecodeError)
+	if !ok {
+		t.Fatalf("decodeErrorFromJSON returned %T, want *DecodeError", decErr)
+	}
+	if de.Code != ErrTypeMismatch {
+		t.Errorf("Code = %v, want ErrTypeMismatch", de.Code)
+	}
+	if de.Field != "done" {
+		t.Errorf("Field = %q, want %q", de.Field, "done")
+	}
+}
@@ -0,0 +1,343 @@
+package openapi
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Exportable is implemented by generated ListResponse* types that support
+// dumping their Data (plus any subsequent pages) to CSV, TSV, or
+// newline-delimited JSON without buffering the whole result set in memory.
+type Exportable[T any] interface {
+	WriteCSV(ctx context.Context, w WriterFlusher, opts ExportOptions[T]) error
+	WriteJSONL(ctx context.Context, w WriterFlusher, opts ExportOptions[T]) error
+}
+
+// WriterFlusher is the subset of *bufio.Writer that export writes through.
+// io.Writer is accepted directly by WriteCSV/WriteJSONL; it is wrapped in a
+// bufio.Writer internally so ChunkSize controls how often it's flushed.
+type WriterFlusher interface {
+	Write(p []byte) (int, error)
+}
+
+// NextPage fetches the page starting at iterator, mirroring the generated
+// API methods' signature for cursor-based pagination.
+type NextPage[T any] func(ctx context.Context, iterator string) (data []T, done bool, nextIterator string, err error)
+
+// ExportOptions configures WriteCSV/WriteJSONL.
+type ExportOptions[T any] struct {
+	// Columns restricts output to the given dotted json-tag paths, in
+	// order. Nil exports every column, derived by reflecting T's json
+	// tags, in struct field order. Applies to WriteCSV/WriteTSV only;
+	// WriteJSONL always writes every field of each element.
+	Columns []string
+	// TimeFormat is used for time.Time fields. Defaults to time.RFC3339.
+	// Applies to WriteCSV/WriteTSV only; WriteJSONL formats times the way
+	// encoding/json does.
+	TimeFormat string
+	// ChunkSize is how many rows are written between explicit flushes of
+	// the underlying writer; the 4096-byte write buffer also flushes on
+	// its own whenever it fills. Zero defaults to 500.
+	ChunkSize int
+	// Next fetches subsequent pages following Iterator. Nil exports only
+	// the page already in Data.
+	Next NextPage[T]
+}
+
+func (o ExportOptions[T]) timeFormat() string {
+	if o.TimeFormat != "" {
+		return o.TimeFormat
+	}
+	return time.RFC3339
+}
+
+func (o ExportOptions[T]) chunkSize() int {
+	if o.ChunkSize > 0 {
+		return o.ChunkSize
+	}
+	return 500
+}
+
+// columnSpec describes one flattened output column, e.g. the
+// `response.status_code` path for a nested struct field.
+type columnSpec struct {
+	path   []string
+	index  []int
+	isNull bool // field type implements the nullable getter/IsSet pattern
+}
+
+func columnsFor(t reflect.Type, opts ExportOptions[any]) []columnSpec {
+	var cols []columnSpec
+	walkStructTags(t, nil, nil, &cols)
+	if len(opts.Columns) == 0 {
+		return cols
+	}
+	byPath := make(map[string]columnSpec, len(cols))
+	for _, c := range cols {
+		byPath[dottedPath(c.path)] = c
+	}
+	filtered := make([]columnSpec, 0, len(opts.Columns))
+	for _, p := range opts.Columns {
+		if c, ok := byPath[p]; ok {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+func dottedPath(path []string) string {
+	out := path[0]
+	for _, p := range path[1:] {
+		out += "." + p
+	}
+	return out
+}
+
+// timeType is reflect.TypeOf(time.Time{}), checked by isLeafStructType so
+// Timestamp-shaped fields become a single formatted column instead of being
+// recursed into their unexported wall/ext/loc internals (which would yield
+// zero columns).
+var timeType = reflect.TypeOf(time.Time{})
+
+// isLeafStructType reports whether t is a struct that should be treated as
+// one column rather than descended into: the Nullable* wrapper types, and
+// time.Time (whose fields are all unexported).
+func isLeafStructType(t reflect.Type) bool {
+	return t == timeType || isNullableType(t)
+}
+
+// walkStructTags reflects a struct type's json tags, descending into nested
+// structs (but not nested nullable wrappers or time.Time, which are treated
+// as leaves) so e.g. a Response struct with a StatusCode field becomes the
+// single column "response.status_code".
+func walkStructTags(t reflect.Type, path []string, index []int, out *[]columnSpec) {
+	if t.Kind() != reflect.Struct {
+		*out = append(*out, columnSpec{path: path, index: index})
+		return
+	}
+	if isLeafStructType(t) {
+		*out = append(*out, columnSpec{path: path, index: index, isNull: isNullableType(t)})
+		return
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" { // unexported
+			continue
+		}
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := f.Name
+		if tag != "" {
+			name, _, _ = cutComma(tag)
+		}
+		if name == "" {
+			name = f.Name
+		}
+		nextIndex := append(append([]int{}, index...), i)
+		ft := f.Type
+		if ft.Kind() == reflect.Struct && !isLeafStructType(ft) {
+			walkStructTags(ft, append(append([]string{}, path...), name), nextIndex, out)
+			continue
+		}
+		*out = append(*out, columnSpec{path: append(append([]string{}, path...), name), index: nextIndex, isNull: isNullableType(ft)})
+	}
+}
+
+func cutComma(s string) (before, after string, found bool) {
+	for i, r := range s {
+		if r == ',' {
+			return s[:i], s[i+1:], true
+		}
+	}
+	return s, "", false
+}
+
+// isNullableType reports whether t is one of the generated Nullable* wrapper
+// types (NullableString, NullableInt32, ...): any struct exposing Get()
+// (*V, bool) and IsSet() bool.
+func isNullableType(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	_, hasGet := t.MethodByName("Get")
+	_, hasIsSet := t.MethodByName("IsSet")
+	return hasGet && hasIsSet
+}
+
+func formatCell(v reflect.Value, spec columnSpec, opts ExportOptions[any]) (cell string, explicitNull bool) {
+	for _, i := range spec.index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return "", false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	if spec.isNull {
+		isSet := v.MethodByName("IsSet").Call(nil)[0].Bool()
+		if !isSet {
+			return "", false
+		}
+		get := v.MethodByName("Get").Call(nil)[0]
+		if get.IsNil() {
+			return "null", true
+		}
+		return fmt.Sprint(get.Elem().Interface()), false
+	}
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(opts.timeFormat()), false
+	}
+	return fmt.Sprint(v.Interface()), false
+}
+
+// WriteCSV streams o.Data, plus any subsequent pages fetched via
+// opts.Next, to w as CSV: a header row derived from the element type's json
+// tags (nested structs flattened to dotted paths), written up front so even
+// a zero-row export still produces a header, then one row per element.
+// NullableString-style fields emit an empty cell when unset and the literal
+// "null" when explicitly null.
+func (o ListResponseMessageAttemptEndpointOut) WriteCSV(ctx context.Context, w WriterFlusher, opts ExportOptions[MessageAttemptEndpointOut]) error {
+	return writeDelimited(ctx, w, opts, o.Data, o.Done, o.GetIteratorOk, ',')
+}
+
+// WriteTSV is WriteCSV with a tab delimiter.
+func (o ListResponseMessageAttemptEndpointOut) WriteTSV(ctx context.Context, w WriterFlusher, opts ExportOptions[MessageAttemptEndpointOut]) error {
+	return writeDelimited(ctx, w, opts, o.Data, o.Done, o.GetIteratorOk, '\t')
+}
+
+// WriteJSONL streams o.Data, plus any subsequent pages fetched via
+// opts.Next, to w as newline-delimited JSON, one element per line.
+func (o ListResponseMessageAttemptEndpointOut) WriteJSONL(ctx context.Context, w WriterFlusher, opts ExportOptions[MessageAttemptEndpointOut]) error {
+	return writeJSONL(ctx, w, opts, o.Data, o.Done, o.GetIteratorOk)
+}
+
+// writeDelimited is the shared implementation behind WriteCSV/WriteTSV for
+// any ListResponse* type: it derives columns from T itself (rather than
+// from the first row seen) so the header is emitted even when first is
+// empty and opts.Next yields no further pages.
+func writeDelimited[T any](ctx context.Context, w WriterFlusher, opts ExportOptions[T], first []T, done bool, iterOk func() (*string, bool), delim rune) error {
+	bw := bufio.NewWriterSize(w, 0)
+	cw := csv.NewWriter(bw)
+	cw.Comma = delim
+
+	anyOpts := ExportOptions[any]{Columns: opts.Columns}
+	cols := columnsFor(reflect.TypeOf((*T)(nil)).Elem(), anyOpts)
+	header := make([]string, len(cols))
+	for i, c := range cols {
+		header[i] = dottedPath(c.path)
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	flushEvery := opts.chunkSize()
+	rows := 0
+	writeRow := func(page []T) error {
+		for _, row := range page {
+			v := reflect.ValueOf(row)
+			record := make([]string, len(cols))
+			for i, c := range cols {
+				cell, null := formatCell(v, c, ExportOptions[any]{TimeFormat: opts.TimeFormat})
+				if null {
+					cell = "null"
+				}
+				record[i] = cell
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+			rows++
+			if rows%flushEvery == 0 {
+				cw.Flush()
+				if err := cw.Error(); err != nil {
+					return err
+				}
+				if err := bw.Flush(); err != nil {
+					return err
+				}
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+
+	if err := writeRow(first); err != nil {
+		return err
+	}
+	if err := exportRemainingPages(ctx, done, iterOk, opts.Next, writeRow); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// writeJSONL is the shared implementation behind WriteJSONL for any
+// ListResponse* type.
+func writeJSONL[T any](ctx context.Context, w WriterFlusher, opts ExportOptions[T], first []T, done bool, iterOk func() (*string, bool)) error {
+	bw := bufio.NewWriterSize(w, 0)
+	enc := json.NewEncoder(bw)
+	flushEvery := opts.chunkSize()
+
+	rows := 0
+	flush := func() error {
+		return bw.Flush()
+	}
+	emit := func(page []T) error {
+		for _, row := range page {
+			if err := enc.Encode(row); err != nil {
+				return err
+			}
+			rows++
+			if rows%flushEvery == 0 {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := emit(first); err != nil {
+		return err
+	}
+	if err := exportRemainingPages(ctx, done, iterOk, opts.Next, emit); err != nil {
+		return err
+	}
+	return flush()
+}
+
+func exportRemainingPages[T any](ctx context.Context, done bool, iterOk func() (*string, bool), next NextPage[T], emit func([]T) error) error {
+	if next == nil || done {
+		return nil
+	}
+	it, ok := iterOk()
+	if !ok || it == nil {
+		return nil
+	}
+	iterator := *it
+	for {
+		page, pageDone, nextIterator, err := next(ctx, iterator)
+		if err != nil {
+			return err
+		}
+		if err := emit(page); err != nil {
+			return err
+		}
+		if pageDone {
+			return nil
+		}
+		iterator = nextIterator
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+	}
+}
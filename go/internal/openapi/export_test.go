@@ -0,0 +1,72 @@
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testNullable mimics the generated Nullable* wrapper shape (Get/IsSet) so
+// export's reflection-based nullable handling can be exercised without
+// depending on the real NullableString type.
+type testNullable struct {
+	value *string
+	isSet bool
+}
+
+func (n testNullable) Get() *string   { return n.value }
+func (n testNullable) IsSet() bool    { return n.isSet }
+func (n *testNullable) Set(v *string) { n.value = v; n.isSet = true }
+
+type testRow struct {
+	Name string       `json:"name"`
+	Note testNullable `json:"note"`
+}
+
+func TestFormatCellNullableUnsetVsExplicitNull(t *testing.T) {
+	cols := columnsFor(reflect.TypeOf(testRow{}), ExportOptions[any]{})
+	var noteCol columnSpec
+	for _, c := range cols {
+		if dottedPath(c.path) == "note" {
+			noteCol = c
+		}
+	}
+	if noteCol.path == nil {
+		t.Fatalf("columnsFor didn't find a note column: %+v", cols)
+	}
+
+	unset := testRow{Name: "a"}
+	cell, null := formatCell(reflect.ValueOf(unset), noteCol, ExportOptions[any]{})
+	if cell != "" || null {
+		t.Errorf("unset Note: got (%q, %v), want (\"\", false)", cell, null)
+	}
+
+	explicitNull := testRow{Name: "b"}
+	explicitNull.Note.Set(nil)
+	cell, null = formatCell(reflect.ValueOf(explicitNull), noteCol, ExportOptions[any]{})
+	if !null {
+		t.Errorf("explicit null Note: got null=%v, want true", null)
+	}
+
+	v := "hi"
+	set := testRow{Name: "c"}
+	set.Note.Set(&v)
+	cell, null = formatCell(reflect.ValueOf(set), noteCol, ExportOptions[any]{})
+	if cell != "hi" || null {
+		t.Errorf("set Note: got (%q, %v), want (\"hi\", false)", cell, null)
+	}
+}
+
+func TestWriteDelimitedEmitsHeaderOnEmptyPage(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeDelimited[testRow](context.Background(), &buf, ExportOptions[testRow]{}, nil, true, func() (*string, bool) { return nil, false }, ',')
+	if err != nil {
+		t.Fatalf("writeDelimited returned error: %v", err)
+	}
+	out := buf.String()
+	if !strings.HasPrefix(out, "name,note") {
+		t.Errorf("writeDelimited with zero rows = %q, want a header line", out)
+	}
+}
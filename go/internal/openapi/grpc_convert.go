@@ -0,0 +1,114 @@
+package openapi
+
+import (
+	svixv1 "github.com/svix/svix-webhooks/go/internal/grpc/svixv1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ToProto converts o to its gRPC wire equivalent, for GRPCBackend and for
+// callers who want to relay an HTTP-fetched page over the gRPC transport.
+// A null Iterator collapses to "" on the wire, whatever the value of Done,
+// since the proto iterator field is not optional.
+func (o ListResponseMessageAttemptEndpointOut) ToProto() *svixv1.ListResponseMessageAttemptEndpointOut {
+	data := make([]*svixv1.MessageAttemptEndpointOut, len(o.Data))
+	for i, d := range o.Data {
+		data[i] = d.ToProto()
+	}
+
+	out := &svixv1.ListResponseMessageAttemptEndpointOut{
+		Data: data,
+		Done: o.Done,
+	}
+	if it := o.Iterator.Get(); it != nil {
+		out.Iterator = *it
+	}
+	if o.PrevIterator.IsSet() {
+		if prev := o.PrevIterator.Get(); prev != nil {
+			out.PrevIterator = prev
+		}
+	}
+	return out
+}
+
+// FromProtoListResponseMessageAttemptEndpointOut converts a gRPC
+// ListResponseMessageAttemptEndpointOut back into the shared Go model so
+// callers get the same type regardless of transport.
+func FromProtoListResponseMessageAttemptEndpointOut(p *svixv1.ListResponseMessageAttemptEndpointOut) ListResponseMessageAttemptEndpointOut {
+	data := make([]MessageAttemptEndpointOut, len(p.Data))
+	for i, d := range p.Data {
+		data[i] = FromProtoMessageAttemptEndpointOut(d)
+	}
+
+	out := ListResponseMessageAttemptEndpointOut{
+		Data: data,
+		Done: p.Done,
+	}
+	out.Iterator.Set(&p.Iterator)
+	if p.PrevIterator != nil {
+		out.SetPrevIterator(*p.PrevIterator)
+	}
+	return out
+}
+
+// ToProto converts a MessageAttemptEndpointOut to its gRPC wire equivalent.
+func (d MessageAttemptEndpointOut) ToProto() *svixv1.MessageAttemptEndpointOut {
+	out := &svixv1.MessageAttemptEndpointOut{
+		Id:                 d.Id,
+		MsgId:              d.MsgId,
+		EndpointId:         d.EndpointId,
+		Status:             messageStatusToProtoName(d.Status),
+		Response:           d.Response,
+		ResponseStatusCode: d.ResponseStatusCode,
+	}
+	if !d.Timestamp.IsZero() {
+		out.Timestamp = timestamppb.New(d.Timestamp)
+	}
+	return out
+}
+
+// messageStatusProtoNames maps MessageStatus (the generated client's int32
+// enum) to the string spelling used on the wire by
+// MessageAttemptEndpointOut.status, since the proto field is a string, not
+// the raw enum ordinal - a bare Go type conversion between them isn't
+// meaningful either direction.
+var messageStatusProtoNames = map[MessageStatus]string{
+	SUCCESS: "SUCCESS",
+	PENDING: "PENDING",
+	FAIL:    "FAIL",
+	SENDING: "SENDING",
+}
+
+var messageStatusFromProtoNames = map[string]MessageStatus{
+	"SUCCESS": SUCCESS,
+	"PENDING": PENDING,
+	"FAIL":    FAIL,
+	"SENDING": SENDING,
+}
+
+func messageStatusToProtoName(s MessageStatus) string {
+	if name, ok := messageStatusProtoNames[s]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+func messageStatusFromProtoName(name string) MessageStatus {
+	return messageStatusFromProtoNames[name]
+}
+
+// FromProtoMessageAttemptEndpointOut converts a gRPC
+// MessageAttemptEndpointOut back into the shared Go model.
+func FromProtoMessageAttemptEndpointOut(p *svixv1.MessageAttemptEndpointOut) MessageAttemptEndpointOut {
+	out := MessageAttemptEndpointOut{
+		Id:                 p.Id,
+		MsgId:              p.MsgId,
+		EndpointId:         p.EndpointId,
+		Status:             messageStatusFromProtoName(p.Status),
+		Response:           p.Response,
+		ResponseStatusCode: p.ResponseStatusCode,
+	}
+	if p.Timestamp != nil {
+		out.Timestamp = p.Timestamp.AsTime()
+	}
+	return out
+}
@@ -13,7 +13,6 @@ package openapi
 import (
 	"encoding/json"
 	"bytes"
-	"fmt"
 )
 
 // checks if the ListResponseMessageAttemptEndpointOut type satisfies the MappedNullable interface at compile time
@@ -193,8 +192,14 @@ func (o *ListResponseMessageAttemptEndpointOut) UnmarshalJSON(data []byte) (err
 		"done",
 		"iterator",
 	}
+	knownProperties := map[string]bool{
+		"data":         true,
+		"done":         true,
+		"iterator":     true,
+		"prevIterator": true,
+	}
 
-	allProperties := make(map[string]interface{})
+	allProperties := make(map[string]json.RawMessage)
 
 	err = json.Unmarshal(data, &allProperties)
 
@@ -202,25 +207,72 @@ func (o *ListResponseMessageAttemptEndpointOut) UnmarshalJSON(data []byte) (err
 		return err;
 	}
 
+	// Every category below (missing-required, unknown-field,
+	// type-mismatch/invalid-nullable) is checked against allProperties
+	// directly, rather than bailing out after the first category, so a
+	// single malformed document reports every problem it has at once.
+	var decodeErrs DecodeErrors
 	for _, requiredProperty := range(requiredProperties) {
 		if _, exists := allProperties[requiredProperty]; !exists {
-			return fmt.Errorf("no value given for required property %v", requiredProperty)
+			decodeErrs = append(decodeErrs, &DecodeError{
+				Code:  ErrMissingRequired,
+				Field: requiredProperty,
+				Path:  jsonPointer(requiredProperty),
+			})
+		}
+	}
+	for field := range allProperties {
+		if !knownProperties[field] {
+			decodeErrs = append(decodeErrs, &DecodeError{
+				Code:  ErrUnknownField,
+				Field: field,
+				Path:  jsonPointer(field),
+			})
+		}
+	}
+	if raw, exists := allProperties["data"]; exists {
+		var v []MessageAttemptEndpointOut
+		if err := json.Unmarshal(raw, &v); err != nil {
+			decodeErrs = append(decodeErrs, &DecodeError{Code: ErrTypeMismatch, Field: "data", Path: jsonPointer("data"), Err: err})
+		}
+	}
+	if raw, exists := allProperties["done"]; exists {
+		var v bool
+		if err := json.Unmarshal(raw, &v); err != nil {
+			decodeErrs = append(decodeErrs, &DecodeError{Code: ErrTypeMismatch, Field: "done", Path: jsonPointer("done"), Err: err})
+		}
+	}
+	if raw, exists := allProperties["iterator"]; exists {
+		var v NullableString
+		if err := v.UnmarshalJSON(raw); err != nil {
+			decodeErrs = append(decodeErrs, &DecodeError{Code: ErrInvalidNullable, Field: "iterator", Path: jsonPointer("iterator"), Err: err})
 		}
 	}
+	if raw, exists := allProperties["prevIterator"]; exists {
+		var v NullableString
+		if err := v.UnmarshalJSON(raw); err != nil {
+			decodeErrs = append(decodeErrs, &DecodeError{Code: ErrInvalidNullable, Field: "prevIterator", Path: jsonPointer("prevIterator"), Err: err})
+		}
+	}
+	if len(decodeErrs) > 0 {
+		return decodeErrs
+	}
 
+	// Unknown fields were already reported above via allProperties, so
+	// DisallowUnknownFields isn't needed here - it would only ever
+	// duplicate that classification with a less precise error.
 	varListResponseMessageAttemptEndpointOut := _ListResponseMessageAttemptEndpointOut{}
 
 	decoder := json.NewDecoder(bytes.NewReader(data))
-	decoder.DisallowUnknownFields()
 	err = decoder.Decode(&varListResponseMessageAttemptEndpointOut)
 
 	if err != nil {
-		return err
+		return decodeErrorFromJSON(err)
 	}
 
 	*o = ListResponseMessageAttemptEndpointOut(varListResponseMessageAttemptEndpointOut)
 
-	return err
+	return nil
 }
 
 type NullableListResponseMessageAttemptEndpointOut struct {
@@ -0,0 +1,270 @@
+package openapi
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// contextKey namespaces context values set by this package, following the
+// same pattern as the existing ContextServerIndex/ContextAccessToken keys.
+type contextKey int
+
+const (
+	contextKeyRetryPolicy contextKey = iota
+	contextKeyIdempotencyKey
+)
+
+// ContextRetryPolicy takes a *RetryPolicy and overrides the Transport's
+// default retry/back-off behavior for calls made with that context, without
+// rebuilding the client.
+var ContextRetryPolicy = contextKeyRetryPolicy
+
+// ContextIdempotencyKey takes a string and, when set, attaches it as the
+// Idempotency-Key header on the request so retried attempts are safely
+// deduplicated by the server.
+var ContextIdempotencyKey = contextKeyIdempotencyKey
+
+// RetryPolicy controls how Transport retries a request.
+type RetryPolicy struct {
+	// NumTries is the maximum number of attempts, mirroring the existing
+	// NumTries constant used elsewhere in the client.
+	NumTries int
+	// BaseDelay and MaxDelay bound the exponential back-off; each attempt
+	// waits min(MaxDelay, BaseDelay*2^attempt) plus up to 20% jitter.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// DefaultRetryPolicy makes up to NumTries attempts per request, whatever
+// its HTTP method.
+var DefaultRetryPolicy = RetryPolicy{
+	NumTries:  NumTries,
+	BaseDelay: 200 * time.Millisecond,
+	MaxDelay:  5 * time.Second,
+}
+
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	d := p.BaseDelay << attempt
+	if d > p.MaxDelay || d <= 0 {
+		d = p.MaxDelay
+	}
+	jitter := time.Duration(rand.Int63n(int64(d)/5 + 1))
+	return d + jitter
+}
+
+// Transport wraps an http.RoundTripper with retries, rate-limit awareness,
+// and an OpenTelemetry span per attempt. It's installed automatically on
+// the Configuration's HTTPClient, so every generated API method (and
+// pagination built on top of it) picks it up without extra wiring.
+type Transport struct {
+	// Base is the underlying RoundTripper. http.DefaultTransport is used
+	// if nil.
+	Base http.RoundTripper
+	// Policy is the default retry policy; a per-call ContextRetryPolicy
+	// value overrides it.
+	Policy RetryPolicy
+	// Tracer is used to start attempt spans. otel.Tracer("svix") is used
+	// if nil.
+	Tracer trace.Tracer
+	// OnRetry, if set, is called before sleeping ahead of each retried
+	// attempt.
+	OnRetry func(req *http.Request, resp *http.Response, attempt int, err error)
+	// OnRateLimit, if set, is called whenever a response's
+	// X-RateLimit-Remaining hits zero, with the time at which the limit
+	// resets.
+	OnRateLimit func(reset time.Time)
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+func (t *Transport) tracer() trace.Tracer {
+	if t.Tracer != nil {
+		return t.Tracer
+	}
+	return otel.Tracer("svix")
+}
+
+func (t *Transport) policy(ctx context.Context) RetryPolicy {
+	if p, ok := ctx.Value(ContextRetryPolicy).(*RetryPolicy); ok && p != nil {
+		return *p
+	}
+	if t.Policy.NumTries > 0 {
+		return t.Policy
+	}
+	return DefaultRetryPolicy
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	policy := t.policy(ctx)
+
+	if key, ok := ctx.Value(ContextIdempotencyKey).(string); ok && key != "" {
+		req.Header.Set("Idempotency-Key", key)
+	}
+
+	var resp *http.Response
+	var err error
+	tries := maxInt(policy.NumTries, 1)
+	for attempt := 0; attempt < tries; attempt++ {
+		if waitErr := t.waitForRateLimit(ctx); waitErr != nil {
+			return nil, waitErr
+		}
+
+		spanCtx, span := t.tracer().Start(ctx, "svix.http.attempt", trace.WithAttributes(
+			attribute.String("http.method", req.Method),
+			attribute.String("http.url", req.URL.String()),
+			attribute.Int("svix.attempt", attempt+1),
+		))
+		attemptReq := req.Clone(spanCtx)
+		if attempt > 0 && req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				span.End()
+				return nil, bodyErr
+			}
+			attemptReq.Body = body
+		}
+
+		resp, err = t.base().RoundTrip(attemptReq)
+
+		if resp != nil {
+			span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+			t.checkRateLimit(resp)
+		}
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		retryReason, shouldRetry := t.shouldRetry(resp, err)
+		if shouldRetry {
+			span.SetAttributes(attribute.String("svix.retry_reason", retryReason))
+		}
+		span.End()
+
+		if !shouldRetry || attempt == tries-1 {
+			return resp, err
+		}
+
+		if t.OnRetry != nil {
+			t.OnRetry(req, resp, attempt+1, err)
+		}
+
+		wait := t.retryAfter(resp)
+		if wait <= 0 {
+			wait = policy.delay(attempt)
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return resp, err
+}
+
+func (t *Transport) shouldRetry(resp *http.Response, err error) (reason string, retry bool) {
+	if err != nil {
+		return "transport_error", true
+	}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return "rate_limited", true
+	case resp.StatusCode >= 500:
+		return "server_error", true
+	default:
+		return "", false
+	}
+}
+
+// retryAfter honors Retry-After (seconds or HTTP-date) ahead of back-off.
+func (t *Transport) retryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if when, err := http.ParseTime(v); err == nil {
+		return time.Until(when)
+	}
+	return 0
+}
+
+// checkRateLimit inspects Svix's X-RateLimit-* headers and, once the
+// remaining quota has hit zero, records the reset time so waitForRateLimit
+// pauses every subsequent in-flight request (on this Transport) until it
+// passes, instead of letting them all fail against a guaranteed 429.
+func (t *Transport) checkRateLimit(resp *http.Response) {
+	remaining := resp.Header.Get("X-RateLimit-Remaining")
+	resetHeader := resp.Header.Get("X-RateLimit-Reset")
+	if remaining != "0" || resetHeader == "" {
+		return
+	}
+	secs, err := strconv.ParseInt(resetHeader, 10, 64)
+	if err != nil {
+		return
+	}
+	// X-RateLimit-Reset is seconds-until-reset, not a Unix epoch.
+	reset := time.Now().Add(time.Duration(secs) * time.Second)
+
+	t.mu.Lock()
+	t.pausedUntil = reset
+	t.mu.Unlock()
+
+	if t.OnRateLimit != nil {
+		t.OnRateLimit(reset)
+	}
+}
+
+// waitForRateLimit blocks until any pause recorded by checkRateLimit has
+// elapsed, or ctx is done.
+func (t *Transport) waitForRateLimit(ctx context.Context) error {
+	t.mu.Lock()
+	until := t.pausedUntil
+	t.mu.Unlock()
+
+	wait := time.Until(until)
+	if wait <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(wait):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,94 @@
+package openapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyDelayBounds(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: 500 * time.Millisecond}
+	for attempt := 0; attempt < 10; attempt++ {
+		d := policy.delay(attempt)
+		// MaxDelay plus up to 20% jitter bounds every attempt, including
+		// large ones where BaseDelay<<attempt would otherwise overflow or
+		// dwarf MaxDelay.
+		if d < 0 || d > policy.MaxDelay+policy.MaxDelay/5 {
+			t.Errorf("attempt %d: delay %v out of bounds for MaxDelay %v", attempt, d, policy.MaxDelay)
+		}
+	}
+}
+
+func TestRetryPolicyDelayGrowsExponentially(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 10 * time.Millisecond, MaxDelay: time.Second}
+	// Jitter makes exact values non-deterministic, so assert the
+	// un-jittered floor grows: delay(attempt) >= BaseDelay<<attempt.
+	for attempt := 0; attempt < 5; attempt++ {
+		d := policy.delay(attempt)
+		floor := policy.BaseDelay << attempt
+		if d < floor {
+			t.Errorf("attempt %d: delay %v below expected floor %v", attempt, d, floor)
+		}
+	}
+}
+
+func TestRetryAfterSeconds(t *testing.T) {
+	tr := &Transport{}
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+	if got, want := tr.retryAfter(resp), 2*time.Second; got != want {
+		t.Errorf("retryAfter(seconds) = %v, want %v", got, want)
+	}
+}
+
+func TestRetryAfterHTTPDate(t *testing.T) {
+	tr := &Transport{}
+	when := time.Now().Add(3 * time.Second).UTC()
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{when.Format(http.TimeFormat)}}}
+	got := tr.retryAfter(resp)
+	if got <= 0 || got > 4*time.Second {
+		t.Errorf("retryAfter(HTTP-date) = %v, want ~3s", got)
+	}
+}
+
+func TestRetryAfterMissingOrInvalid(t *testing.T) {
+	tr := &Transport{}
+	if got := tr.retryAfter(&http.Response{Header: http.Header{}}); got != 0 {
+		t.Errorf("retryAfter(no header) = %v, want 0", got)
+	}
+	if got := tr.retryAfter(&http.Response{Header: http.Header{"Retry-After": []string{"not-a-number-or-date"}}}); got != 0 {
+		t.Errorf("retryAfter(garbage) = %v, want 0", got)
+	}
+	if got := tr.retryAfter(nil); got != 0 {
+		t.Errorf("retryAfter(nil) = %v, want 0", got)
+	}
+}
+
+func TestCheckRateLimitUsesDeltaSecondsNotEpoch(t *testing.T) {
+	tr := &Transport{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "5")
+	}))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatalf("http.Get: %v", err)
+	}
+	defer resp.Body.Close()
+
+	before := time.Now()
+	tr.checkRateLimit(resp)
+	after := time.Now()
+
+	tr.mu.Lock()
+	pausedUntil := tr.pausedUntil
+	tr.mu.Unlock()
+
+	// 5 delta-seconds from "now" should land a handful of seconds out, not
+	// at the Unix epoch (1970) and not decades in the future.
+	if pausedUntil.Before(before.Add(4*time.Second)) || pausedUntil.After(after.Add(6*time.Second)) {
+		t.Errorf("pausedUntil = %v, want ~5s from %v", pausedUntil, before)
+	}
+}
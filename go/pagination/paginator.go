@@ -0,0 +1,189 @@
+// Package pagination provides a generic helper for walking the cursor-based
+// list endpoints exposed by the generated openapi client, so callers don't
+// have to hand-roll a loop around Iterator/PrevIterator/Done on every
+// ListResponse* type.
+package pagination
+
+import (
+	"context"
+	"time"
+
+	"github.com/svix/svix-webhooks/go/internal/openapi"
+)
+
+// ListResponse is satisfied by every generated ListResponse* model. Data is
+// the page's element type, e.g. MessageAttemptEndpointOut.
+type ListResponse[T any] interface {
+	GetData() []T
+	GetDone() bool
+	GetIteratorOk() (*string, bool)
+	GetPrevIteratorOk() (*string, bool)
+}
+
+// Fetcher retrieves a single page starting at iterator. An empty iterator
+// requests the first page. limit is the requested page size and is ignored
+// when zero.
+type Fetcher[T any, R ListResponse[T]] func(ctx context.Context, iterator string, limit int) (R, error)
+
+// Options configures a Paginator.
+type Options struct {
+	// PageSize is passed through to the Fetcher as the `limit` parameter.
+	// Zero leaves it up to the endpoint's default.
+	PageSize int
+	// MaxPages bounds how many pages Next/All/Stream will fetch before
+	// stopping early, even if Done is still false. Zero means unbounded.
+	MaxPages int
+	// Backward walks PrevIterator instead of Iterator.
+	Backward bool
+	// RetryPolicy, if set, is consulted between failed fetch attempts.
+	// It mirrors the retry/back-off behavior already used for NumTries.
+	RetryPolicy RetryPolicy
+}
+
+// RetryPolicy decides whether attempt (1-indexed) should be retried after
+// err, and if so how long to wait first.
+type RetryPolicy interface {
+	ShouldRetry(attempt int, err error) (wait time.Duration, retry bool)
+}
+
+// defaultRetryPolicy reuses the client's existing NumTries budget with a
+// flat back-off, matching the behavior callers already get from the
+// generated client today.
+type defaultRetryPolicy struct{}
+
+func (defaultRetryPolicy) ShouldRetry(attempt int, _ error) (time.Duration, bool) {
+	return 0, attempt < openapi.NumTries
+}
+
+// PageResult is delivered on the channel returned by Stream.
+type PageResult[T any] struct {
+	Page []T
+	Err  error
+}
+
+// Paginator walks a single ListResponse* endpoint, transparently following
+// Iterator (or PrevIterator, with Options.Backward) until Done is true or
+// Options.MaxPages is reached.
+type Paginator[T any, R ListResponse[T]] struct {
+	fetch    Fetcher[T, R]
+	opts     Options
+	iterator string
+	done     bool
+	pages    int
+}
+
+// New constructs a Paginator around fetch. fetch is typically a thin
+// closure adapting a generated API method's builder-style call to the
+// Fetcher signature, e.g.
+//
+//	pagination.New(
+//		func(ctx context.Context, iterator string, limit int) (*openapi.ListResponseMessageAttemptEndpointOut, error) {
+//			req := client.MessageAttemptApi.ListAttemptsByEndpoint(ctx, appID, endpointID)
+//			if iterator != "" {
+//				req = req.Iterator(iterator)
+//			}
+//			if limit > 0 {
+//				req = req.Limit(int32(limit))
+//			}
+//			return req.Execute()
+//		},
+//		pagination.Options{},
+//	)
+//
+// See NewMessageAttemptPaginator for a wrapper that fixes the type
+// parameters for ListResponseMessageAttemptEndpointOut; it still takes a
+// fetch closure like the one above.
+func New[T any, R ListResponse[T]](fetch Fetcher[T, R], opts Options) *Paginator[T, R] {
+	if opts.RetryPolicy == nil {
+		opts.RetryPolicy = defaultRetryPolicy{}
+	}
+	return &Paginator[T, R]{fetch: fetch, opts: opts}
+}
+
+// Next fetches and returns the next page. It returns (nil, nil) once the
+// paginator is exhausted.
+func (p *Paginator[T, R]) Next(ctx context.Context) ([]T, error) {
+	if p.done || (p.opts.MaxPages > 0 && p.pages >= p.opts.MaxPages) {
+		return nil, nil
+	}
+
+	var resp R
+	var err error
+	for attempt := 1; ; attempt++ {
+		resp, err = p.fetch(ctx, p.iterator, p.opts.PageSize)
+		if err == nil {
+			break
+		}
+		wait, retry := p.opts.RetryPolicy.ShouldRetry(attempt, err)
+		if !retry {
+			return nil, err
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	p.pages++
+	p.done = resp.GetDone()
+
+	next := resp.GetIteratorOk
+	if p.opts.Backward {
+		next = resp.GetPrevIteratorOk
+	}
+	if it, ok := next(); ok && it != nil {
+		p.iterator = *it
+	} else {
+		p.done = true
+	}
+
+	return resp.GetData(), nil
+}
+
+// All drains every remaining page and concatenates them into a single
+// slice. Prefer Stream for result sets too large to hold in memory at once.
+func (p *Paginator[T, R]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for {
+		page, err := p.Next(ctx)
+		if err != nil {
+			return all, err
+		}
+		if page == nil {
+			return all, nil
+		}
+		all = append(all, page...)
+	}
+}
+
+// Stream fetches pages on demand and delivers them over the returned
+// channel, providing back-pressure: the next page isn't fetched until the
+// caller has received the previous one. The channel is closed when the
+// paginator is exhausted, ctx is done, or a fetch returns an error (which is
+// delivered as the final PageResult).
+func (p *Paginator[T, R]) Stream(ctx context.Context) <-chan PageResult[T] {
+	out := make(chan PageResult[T])
+	go func() {
+		defer close(out)
+		for {
+			page, err := p.Next(ctx)
+			if err != nil {
+				select {
+				case out <- PageResult[T]{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+			if page == nil {
+				return
+			}
+			select {
+			case out <- PageResult[T]{Page: page}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
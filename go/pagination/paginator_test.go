@@ -0,0 +1,148 @@
+package pagination
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakePage is a minimal ListResponse[string] used to drive Paginator without
+// depending on any generated openapi model.
+type fakePage struct {
+	data         []string
+	done         bool
+	iterator     string
+	prevIterator string
+	hasPrev      bool
+}
+
+func (p fakePage) GetData() []string { return p.data }
+func (p fakePage) GetDone() bool     { return p.done }
+func (p fakePage) GetIteratorOk() (*string, bool) {
+	it := p.iterator
+	return &it, true
+}
+func (p fakePage) GetPrevIteratorOk() (*string, bool) {
+	if !p.hasPrev {
+		return nil, false
+	}
+	prev := p.prevIterator
+	return &prev, true
+}
+
+func fakeFetcher(pages map[string]fakePage) Fetcher[string, fakePage] {
+	return func(_ context.Context, iterator string, _ int) (fakePage, error) {
+		page, ok := pages[iterator]
+		if !ok {
+			return fakePage{}, errors.New("no page for iterator " + iterator)
+		}
+		return page, nil
+	}
+}
+
+func TestPaginatorAllForward(t *testing.T) {
+	pages := map[string]fakePage{
+		"":   {data: []string{"a", "b"}, iterator: "p2"},
+		"p2": {data: []string{"c"}, done: true},
+	}
+	p := New[string, fakePage](fakeFetcher(pages), Options{})
+
+	got, err := p.All(context.Background())
+	if err != nil {
+		t.Fatalf("All returned error: %v", err)
+	}
+	want := []string{"a", "b", "c"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestPaginatorBackward(t *testing.T) {
+	pages := map[string]fakePage{
+		"":   {data: []string{"z"}, prevIterator: "p1", hasPrev: true},
+		"p1": {data: []string{"y"}, done: true},
+	}
+	p := New[string, fakePage](fakeFetcher(pages), Options{Backward: true})
+
+	got, err := p.All(context.Background())
+	if err != nil {
+		t.Fatalf("All returned error: %v", err)
+	}
+	want := []string{"z", "y"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestPaginatorMaxPages(t *testing.T) {
+	pages := map[string]fakePage{
+		"":   {data: []string{"a"}, iterator: "p2"},
+		"p2": {data: []string{"b"}, iterator: "p3"},
+		"p3": {data: []string{"c"}, done: true},
+	}
+	p := New[string, fakePage](fakeFetcher(pages), Options{MaxPages: 2})
+
+	got, err := p.All(context.Background())
+	if err != nil {
+		t.Fatalf("All returned error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("MaxPages: got %v, want 2 pages worth of data", got)
+	}
+}
+
+// countingRetryPolicy records every ShouldRetry call and retries up to max
+// times, so tests can assert a fetch error is retried rather than
+// propagated immediately.
+type countingRetryPolicy struct {
+	max   int
+	Calls int
+}
+
+func (p *countingRetryPolicy) ShouldRetry(attempt int, _ error) (time.Duration, bool) {
+	p.Calls++
+	return 0, attempt < p.max
+}
+
+func TestPaginatorRetriesThenSucceeds(t *testing.T) {
+	calls := 0
+	fetch := func(_ context.Context, iterator string, _ int) (fakePage, error) {
+		calls++
+		if calls < 3 {
+			return fakePage{}, errors.New("transient")
+		}
+		return fakePage{data: []string{"ok"}, done: true}, nil
+	}
+	retry := &countingRetryPolicy{max: 5}
+	p := New[string, fakePage](fetch, Options{RetryPolicy: retry})
+
+	got, err := p.Next(context.Background())
+	if err != nil {
+		t.Fatalf("Next returned error: %v", err)
+	}
+	if len(got) != 1 || got[0] != "ok" {
+		t.Fatalf("got %v, want [ok]", got)
+	}
+	if retry.Calls != 2 {
+		t.Fatalf("expected 2 retry consultations before success, got %d", retry.Calls)
+	}
+}
+
+func TestPaginatorGivesUpWhenRetryPolicyDeclines(t *testing.T) {
+	wantErr := errors.New("permanent")
+	fetch := func(_ context.Context, _ string, _ int) (fakePage, error) {
+		return fakePage{}, wantErr
+	}
+	p := New[string, fakePage](fetch, Options{RetryPolicy: &countingRetryPolicy{max: 0}})
+
+	_, err := p.Next(context.Background())
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("got error %v, want %v", err, wantErr)
+	}
+}
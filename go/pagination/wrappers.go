@@ -0,0 +1,27 @@
+package pagination
+
+import (
+	"context"
+
+	"github.com/svix/svix-webhooks/go/internal/openapi"
+)
+
+// MessageAttemptPaginator walks ListResponseMessageAttemptEndpointOut pages
+// without callers needing to name the ListResponse type parameter
+// themselves. Additional `<Model>Paginator` wrappers follow this same shape
+// as new ListResponse* models pick up pagination support.
+//
+// The type parameter is *openapi.ListResponseMessageAttemptEndpointOut, not
+// the value type: GetData/GetDone/GetIteratorOk/GetPrevIteratorOk are all
+// declared with pointer receivers, so only the pointer type satisfies
+// ListResponse[T].
+type MessageAttemptPaginator = Paginator[openapi.MessageAttemptEndpointOut, *openapi.ListResponseMessageAttemptEndpointOut]
+
+// MessageAttemptFetcher matches the shape of the generated
+// MessageAttemptApi list methods, e.g. ListAttemptsByEndpoint.
+type MessageAttemptFetcher func(ctx context.Context, iterator string, limit int) (*openapi.ListResponseMessageAttemptEndpointOut, error)
+
+// NewMessageAttemptPaginator builds a MessageAttemptPaginator around fetch.
+func NewMessageAttemptPaginator(fetch MessageAttemptFetcher, opts Options) *MessageAttemptPaginator {
+	return New[openapi.MessageAttemptEndpointOut, *openapi.ListResponseMessageAttemptEndpointOut](Fetcher[openapi.MessageAttemptEndpointOut, *openapi.ListResponseMessageAttemptEndpointOut](fetch), opts)
+}